@@ -0,0 +1,117 @@
+package tflint
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+const (
+	defaultMaxBatchSize = 100
+	defaultMaxLatency   = 3 * time.Second
+)
+
+// IssueBatcherOption configures an IssueBatcher returned by Client.IssueWriter.
+type IssueBatcherOption func(*IssueBatcher)
+
+// WithMaxBatchSize overrides the number of issues an IssueBatcher buffers before
+// flushing automatically. The default is 100.
+func WithMaxBatchSize(n int) IssueBatcherOption {
+	return func(b *IssueBatcher) {
+		b.maxBatchSize = n
+	}
+}
+
+// WithMaxLatency overrides how long an IssueBatcher waits before flushing a
+// non-empty, non-full buffer automatically. The default is 3 seconds.
+func WithMaxLatency(d time.Duration) IssueBatcherOption {
+	return func(b *IssueBatcher) {
+		b.maxLatency = d
+	}
+}
+
+// IssueBatcher buffers issues emitted by a rule and flushes them to the host
+// process N at a time, turning what would be one RPC per issue into one RPC
+// per batch. Obtain one via Client.IssueWriter and call Flush when the rule
+// is done emitting issues.
+type IssueBatcher struct {
+	client *Client
+
+	maxBatchSize int
+	maxLatency   time.Duration
+
+	mu    sync.Mutex
+	buf   []*EmitIssueRequest
+	timer *time.Timer
+}
+
+// IssueWriter returns an IssueBatcher that buffers issues emitted through it
+// and flushes them to the host process in batches.
+func (c *Client) IssueWriter(opts ...IssueBatcherOption) *IssueBatcher {
+	b := &IssueBatcher{
+		client:       c,
+		maxBatchSize: defaultMaxBatchSize,
+		maxLatency:   defaultMaxLatency,
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// EmitIssue buffers an issue, flushing the batch immediately once it reaches
+// the configured max batch size.
+func (b *IssueBatcher) EmitIssue(ctx context.Context, rule Rule, message string, location hcl.Range, meta Metadata) error {
+	b.mu.Lock()
+	b.buf = append(b.buf, &EmitIssueRequest{
+		Rule:     newObjectFromRule(rule),
+		Message:  message,
+		Location: location,
+		Meta:     meta,
+	})
+
+	full := len(b.buf) >= b.maxBatchSize
+	if !full && b.timer == nil {
+		b.timer = time.AfterFunc(b.maxLatency, func() { _ = b.Flush(context.Background()) })
+	}
+	b.mu.Unlock()
+
+	if full {
+		return b.Flush(ctx)
+	}
+	return nil
+}
+
+// Flush sends every buffered issue to the host process in a single RPC.
+// Rules should call Flush when they are done emitting issues, since the
+// batcher otherwise only flushes once it reaches its max batch size or max latency.
+func (b *IssueBatcher) Flush(ctx context.Context) error {
+	b.mu.Lock()
+	buf := b.buf
+	b.buf = nil
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	b.mu.Unlock()
+
+	if len(buf) == 0 {
+		return nil
+	}
+
+	req, err := encodeEmitIssuesRequest(buf)
+	if err != nil {
+		return err
+	}
+
+	resp, err := b.client.client.EmitIssues(ctx, req)
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return errorFromResponse(resp.Error)
+	}
+	return nil
+}
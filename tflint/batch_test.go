@@ -0,0 +1,112 @@
+package tflint
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/kcraley/tflint-plugin-sdk/tflint/proto"
+)
+
+// fakePluginClient implements proto.PluginClient, recording EmitIssues calls.
+// Embedding the nil interface lets it satisfy the other methods without
+// implementing them; IssueBatcher only ever calls EmitIssues.
+type fakePluginClient struct {
+	proto.PluginClient
+
+	resp *proto.EmitIssuesResponse
+	err  error
+
+	calls []*proto.EmitIssuesRequest
+}
+
+func (f *fakePluginClient) EmitIssues(ctx context.Context, in *proto.EmitIssuesRequest, opts ...grpc.CallOption) (*proto.EmitIssuesResponse, error) {
+	f.calls = append(f.calls, in)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.resp, nil
+}
+
+func newTestBatcher(fake *fakePluginClient, opts ...IssueBatcherOption) *IssueBatcher {
+	return (&Client{client: fake}).IssueWriter(opts...)
+}
+
+func TestIssueBatcher_FlushEmptyIsNoop(t *testing.T) {
+	fake := &fakePluginClient{resp: &proto.EmitIssuesResponse{}}
+	b := newTestBatcher(fake)
+
+	if err := b.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() = %v, want nil", err)
+	}
+	if len(fake.calls) != 0 {
+		t.Errorf("Flush() on an empty batcher made %d RPC calls, want 0", len(fake.calls))
+	}
+}
+
+func TestIssueBatcher_FlushSendsBufferedIssues(t *testing.T) {
+	fake := &fakePluginClient{resp: &proto.EmitIssuesResponse{}}
+	b := newTestBatcher(fake, WithMaxBatchSize(10))
+
+	b.buf = []*EmitIssueRequest{
+		{Rule: &RuleObject{}, Message: "first"},
+		{Rule: &RuleObject{}, Message: "second"},
+	}
+
+	if err := b.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() = %v, want nil", err)
+	}
+	if len(fake.calls) != 1 {
+		t.Fatalf("Flush() made %d RPC calls, want 1", len(fake.calls))
+	}
+	if got := len(fake.calls[0].Issues); got != 2 {
+		t.Errorf("EmitIssuesRequest carried %d issues, want 2", got)
+	}
+	if len(b.buf) != 0 {
+		t.Errorf("Flush() left %d issues buffered, want 0", len(b.buf))
+	}
+}
+
+func TestIssueBatcher_FlushPropagatesRPCError(t *testing.T) {
+	fake := &fakePluginClient{err: errors.New("connection refused")}
+	b := newTestBatcher(fake)
+	b.buf = []*EmitIssueRequest{{Rule: &RuleObject{}, Message: "issue"}}
+
+	if err := b.Flush(context.Background()); err == nil {
+		t.Fatal("Flush() = nil, want the underlying RPC error")
+	}
+}
+
+func TestIssueBatcher_FlushPropagatesResponseError(t *testing.T) {
+	fake := &fakePluginClient{resp: &proto.EmitIssuesResponse{
+		Error: &proto.ErrorResponse{Code: int32(InternalError), Message: "host rejected issue"},
+	}}
+	b := newTestBatcher(fake)
+	b.buf = []*EmitIssueRequest{{Rule: &RuleObject{}, Message: "issue"}}
+
+	err := b.Flush(context.Background())
+	if err == nil {
+		t.Fatal("Flush() = nil, want the error from the response")
+	}
+	var appErr *Error
+	if !errors.As(err, &appErr) || appErr.Code != InternalError {
+		t.Errorf("Flush() error = %v, want an *Error with Code = InternalError", err)
+	}
+}
+
+func TestIssueBatcher_FlushStopsPendingTimer(t *testing.T) {
+	fake := &fakePluginClient{resp: &proto.EmitIssuesResponse{}}
+	b := newTestBatcher(fake)
+	b.buf = []*EmitIssueRequest{{Rule: &RuleObject{}, Message: "issue"}}
+	b.timer = time.AfterFunc(time.Hour, func() {})
+
+	if err := b.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush() = %v, want nil", err)
+	}
+	if b.timer != nil {
+		t.Error("Flush() left a non-nil timer behind")
+	}
+}
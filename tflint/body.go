@@ -0,0 +1,67 @@
+package tflint
+
+import (
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// remoteBody is the hcl.Body a plugin gets back for a block obtained from
+// WalkResourceBlocks. Like remoteExpression, it carries the raw source bytes
+// and range it was built from; unlike remoteExpression, it reparses them
+// lazily with hclsyntax, so the host never needs to serialize a concrete
+// hcl.Body implementation.
+type remoteBody struct {
+	bytes []byte
+	rng   hcl.Range
+
+	parsed hcl.Body
+	diags  hcl.Diagnostics
+}
+
+var _ hcl.Body = (*remoteBody)(nil)
+
+func (b *remoteBody) body() (hcl.Body, hcl.Diagnostics) {
+	if b.parsed == nil && b.diags == nil {
+		file, diags := hclsyntax.ParseConfig(b.bytes, b.rng.Filename, b.rng.Start)
+		b.diags = diags
+		if file != nil {
+			b.parsed = file.Body
+		}
+	}
+	return b.parsed, b.diags
+}
+
+func (b *remoteBody) Content(schema *hcl.BodySchema) (*hcl.BodyContent, hcl.Diagnostics) {
+	body, diags := b.body()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	content, contentDiags := body.Content(schema)
+	return content, append(diags, contentDiags...)
+}
+
+func (b *remoteBody) PartialContent(schema *hcl.BodySchema) (*hcl.BodyContent, hcl.Body, hcl.Diagnostics) {
+	body, diags := b.body()
+	if diags.HasErrors() {
+		return nil, nil, diags
+	}
+	content, remain, contentDiags := body.PartialContent(schema)
+	return content, remain, append(diags, contentDiags...)
+}
+
+func (b *remoteBody) JustAttributes() (hcl.Attributes, hcl.Diagnostics) {
+	body, diags := b.body()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	attrs, attrDiags := body.JustAttributes()
+	return attrs, append(diags, attrDiags...)
+}
+
+func (b *remoteBody) MissingItemRange() hcl.Range {
+	body, diags := b.body()
+	if diags.HasErrors() {
+		return b.rng
+	}
+	return body.MissingItemRange()
+}
@@ -0,0 +1,59 @@
+package tflint
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+func TestRemoteBody_Content(t *testing.T) {
+	b := &remoteBody{
+		bytes: []byte(`name = "foo"`),
+		rng:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 1}},
+	}
+
+	content, diags := b.Content(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "name", Required: true}},
+	})
+	if diags.HasErrors() {
+		t.Fatalf("Content: %s", diags)
+	}
+
+	val, diags := content.Attributes["name"].Expr.Value(nil)
+	if diags.HasErrors() {
+		t.Fatalf("Expr.Value: %s", diags)
+	}
+	if got := val.AsString(); got != "foo" {
+		t.Errorf("name = %q, want %q", got, "foo")
+	}
+}
+
+func TestRemoteBody_JustAttributes(t *testing.T) {
+	b := &remoteBody{
+		bytes: []byte(`a = "1"
+b = "2"`),
+		rng: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 1}},
+	}
+
+	attrs, diags := b.JustAttributes()
+	if diags.HasErrors() {
+		t.Fatalf("JustAttributes: %s", diags)
+	}
+	if len(attrs) != 2 {
+		t.Errorf("got %d attributes, want 2", len(attrs))
+	}
+}
+
+func TestRemoteBody_ParseError(t *testing.T) {
+	b := &remoteBody{
+		bytes: []byte(`this is not valid hcl {{{`),
+		rng:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 1}},
+	}
+
+	if _, diags := b.JustAttributes(); !diags.HasErrors() {
+		t.Error("JustAttributes() on unparseable bytes returned no diagnostics, want parse errors")
+	}
+	if got := b.MissingItemRange(); got != b.rng {
+		t.Errorf("MissingItemRange() on unparseable bytes = %+v, want the original range %+v", got, b.rng)
+	}
+}
@@ -1,29 +1,32 @@
 package tflint
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"net"
-	"net/rpc"
 
 	hcl "github.com/hashicorp/hcl/v2"
 	"github.com/zclconf/go-cty/cty"
 	"github.com/zclconf/go-cty/cty/convert"
 	"github.com/zclconf/go-cty/cty/gocty"
+	"google.golang.org/grpc"
+
+	"github.com/kcraley/tflint-plugin-sdk/tflint/proto"
 )
 
-// Client is an RPC client for plugins to query the host process for Terraform configurations
-// Actually, it is an RPC client, but its details are hidden on the plugin side because it satisfies the Runner interface
+// Client is a gRPC client for plugins to query the host process for Terraform configurations.
+// Actually, it is a gRPC client, but its details are hidden on the plugin side because it satisfies the Runner interface.
 type Client struct {
-	rpcClient *rpc.Client
+	client proto.PluginClient
 }
 
-// NewClient returns a new Client
-func NewClient(conn net.Conn) *Client {
-	return &Client{rpcClient: rpc.NewClient(conn)}
+// NewClient returns a new Client backed by the given gRPC connection.
+func NewClient(conn *grpc.ClientConn) *Client {
+	return &Client{client: proto.NewPluginClient(conn)}
 }
 
-// AttributesRequest is the interface used to communicate via RPC.
+// AttributesRequest holds the parameters of an attributes query. Client
+// itself sends them as a proto.AttributesRequest.
 type AttributesRequest struct {
 	Resource      string
 	AttributeName string
@@ -31,14 +34,24 @@ type AttributesRequest struct {
 
 // WalkResourceAttributes queries the host process, receives a list of attributes that match the conditions,
 // and passes each to the walker function.
-func (c *Client) WalkResourceAttributes(resource, attributeName string, walker func(*hcl.Attribute) error) error {
-	var resp hcl.Attributes
-	if err := c.rpcClient.Call("Plugin.Attributes", AttributesRequest{Resource: resource, AttributeName: attributeName}, &resp); err != nil {
+func (c *Client) WalkResourceAttributes(ctx context.Context, resource, attributeName string, walker func(*hcl.Attribute) error) error {
+	resp, err := c.client.Attributes(ctx, &proto.AttributesRequest{
+		Resource:      resource,
+		AttributeName: attributeName,
+	})
+	if err != nil {
 		return err
 	}
+	if resp.Error != nil {
+		return errorFromResponse(resp.Error)
+	}
 
-	for _, attribute := range resp {
-		if err := walker(attribute); err != nil {
+	for _, attribute := range resp.Attributes {
+		attr, err := decodeAttribute(attribute)
+		if err != nil {
+			return err
+		}
+		if err := walker(attr); err != nil {
 			return err
 		}
 	}
@@ -48,14 +61,43 @@ func (c *Client) WalkResourceAttributes(resource, attributeName string, walker f
 
 // EvaluateExpr queries the host process for the result of evaluating the value of the passed expression
 // and reflects it as the value of the second argument based on that.
-func (c *Client) EvaluateExpr(expr hcl.Expression, ret interface{}) error {
-	var val cty.Value
-	var err error
+func (c *Client) EvaluateExpr(ctx context.Context, expr hcl.Expression, ret interface{}) error {
+	resp, err := c.client.EvalExpr(ctx, &proto.EvalExprRequest{Expr: encodeExpr(expr)})
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return errorFromResponse(resp.Error)
+	}
 
-	if err := c.rpcClient.Call("Plugin.EvalExpr", &expr, &val); err != nil {
+	val, err := decodeCtyValue(resp.Value)
+	if err != nil {
 		return err
 	}
 
+	if !val.IsKnown() {
+		return &Error{
+			Code:  UnknownValue,
+			Level: WarningLevel,
+			Message: fmt.Sprintf(
+				"Unknown value in %s:%d",
+				expr.Range().Filename,
+				expr.Range().Start.Line,
+			),
+		}
+	}
+	if val.IsNull() {
+		return &Error{
+			Code:  NullValue,
+			Level: WarningLevel,
+			Message: fmt.Sprintf(
+				"Null value in %s:%d",
+				expr.Range().Filename,
+				expr.Range().Start.Line,
+			),
+		}
+	}
+
 	switch ret.(type) {
 	case *string:
 		val, err = convert.Convert(val, cty.String)
@@ -104,7 +146,7 @@ func (c *Client) EvaluateExpr(expr hcl.Expression, ret interface{}) error {
 	return nil
 }
 
-// EmitIssueRequest is the interface used to communicate via RPC.
+// EmitIssueRequest is the interface used to communicate via gRPC.
 type EmitIssueRequest struct {
 	Rule     *RuleObject
 	Message  string
@@ -115,16 +157,24 @@ type EmitIssueRequest struct {
 // EmitIssue emits attributes to build the issue to the host process
 // Note that the passed rule need to be converted to generic objects
 // because the custom structure defined in the plugin cannot be sent via RPC.
-func (c *Client) EmitIssue(rule Rule, message string, location hcl.Range, meta Metadata) error {
-	req := &EmitIssueRequest{
+func (c *Client) EmitIssue(ctx context.Context, rule Rule, message string, location hcl.Range, meta Metadata) error {
+	req, err := encodeEmitIssueRequest(&EmitIssueRequest{
 		Rule:     newObjectFromRule(rule),
 		Message:  message,
 		Location: location,
 		Meta:     meta,
+	})
+	if err != nil {
+		return err
 	}
-	if err := c.rpcClient.Call("Plugin.EmitIssue", &req, new(interface{})); err != nil {
+
+	resp, err := c.client.EmitIssue(ctx, req)
+	if err != nil {
 		return err
 	}
+	if resp.Error != nil {
+		return errorFromResponse(resp.Error)
+	}
 	return nil
 }
 
@@ -139,12 +189,13 @@ func (*Client) EnsureNoError(err error, proc func() error) error {
 		switch appErr.Level {
 		case WarningLevel:
 			return nil
-		case ErrorLevel:
-			return appErr
 		default:
-			panic(appErr)
+			// Treat anything other than WarningLevel, including a Level we
+			// don't recognize, as an error: a host should never be able to
+			// crash a plugin by sending an out-of-range severity.
+			return appErr
 		}
 	} else {
 		return err
 	}
-}
\ No newline at end of file
+}
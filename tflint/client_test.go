@@ -0,0 +1,80 @@
+package tflint
+
+import (
+	"context"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"google.golang.org/grpc"
+
+	"github.com/kcraley/tflint-plugin-sdk/tflint/proto"
+)
+
+// fakeAttributesClient implements proto.PluginClient, recording the ctx it
+// received from Attributes and returning a canned response.
+type fakeAttributesClient struct {
+	proto.PluginClient
+
+	gotCtx context.Context
+	resp   *proto.AttributesResponse
+}
+
+func (f *fakeAttributesClient) Attributes(ctx context.Context, in *proto.AttributesRequest, opts ...grpc.CallOption) (*proto.AttributesResponse, error) {
+	f.gotCtx = ctx
+	return f.resp, nil
+}
+
+func TestClient_WalkResourceAttributes_ForwardsContextAndDecodesAttributes(t *testing.T) {
+	fake := &fakeAttributesClient{
+		resp: &proto.AttributesResponse{
+			Attributes: []*proto.Attribute{
+				{
+					Name:  "ami",
+					Expr:  []byte(`"abc123"`),
+					Range: encodeRange(hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 1}, End: hcl.Pos{Line: 1, Column: 5}}),
+				},
+			},
+		},
+	}
+	c := &Client{client: fake}
+
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "caller")
+
+	var got []*hcl.Attribute
+	err := c.WalkResourceAttributes(ctx, "aws_instance", "ami", func(attr *hcl.Attribute) error {
+		got = append(got, attr)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkResourceAttributes: %v", err)
+	}
+	if fake.gotCtx != ctx {
+		t.Error("WalkResourceAttributes did not forward the caller's context")
+	}
+	if len(got) != 1 || got[0].Name != "ami" {
+		t.Errorf("walker saw %+v, want a single ami attribute", got)
+	}
+}
+
+// fakeErrorClient implements proto.PluginClient, returning an ErrorResponse
+// from Attributes so callers can exercise the errorFromResponse path.
+type fakeErrorClient struct {
+	proto.PluginClient
+}
+
+func (f *fakeErrorClient) Attributes(ctx context.Context, in *proto.AttributesRequest, opts ...grpc.CallOption) (*proto.AttributesResponse, error) {
+	return &proto.AttributesResponse{Error: &proto.ErrorResponse{Code: int32(ResourceNotFound), Message: "no such resource"}}, nil
+}
+
+func TestClient_WalkResourceAttributes_PropagatesResponseError(t *testing.T) {
+	c := &Client{client: &fakeErrorClient{}}
+
+	err := c.WalkResourceAttributes(context.Background(), "aws_instance", "ami", func(*hcl.Attribute) error {
+		t.Fatal("walker should not run when the response carries an error")
+		return nil
+	})
+	if err == nil {
+		t.Fatal("WalkResourceAttributes returned nil, want the response's error")
+	}
+}
@@ -0,0 +1,146 @@
+package tflint
+
+import (
+	"errors"
+	"fmt"
+
+	hcl "github.com/hashicorp/hcl/v2"
+
+	"github.com/kcraley/tflint-plugin-sdk/tflint/proto"
+)
+
+// Level represents the severity of an Error.
+type Level int32
+
+const (
+	// WarningLevel means the error should not interrupt the caller; EnsureNoError skips it silently.
+	WarningLevel Level = iota
+	// ErrorLevel means the error should be returned to the caller.
+	ErrorLevel
+)
+
+// Code is a stable, machine-readable identifier for an Error that survives
+// the RPC boundary, so callers can branch on errors.Is(err, tflint.ErrXxx)
+// even once the error has been reconstructed from an ErrorResponse.
+type Code int32
+
+const (
+	// UnknownValue means the expression evaluates to a value that is not yet known,
+	// such as one derived from an unevaluated variable.
+	UnknownValue Code = iota
+	// NullValue means the expression evaluates to null.
+	NullValue
+	// UnevaluableExpr means the expression cannot be evaluated at all.
+	UnevaluableExpr
+	// ResourceNotFound means the requested resource does not exist in the configuration.
+	ResourceNotFound
+	// TypeConversionError means the evaluated value could not be converted to the requested type.
+	TypeConversionError
+	// TypeMismatchError means the evaluated value does not match the Go type the caller asked for.
+	TypeMismatchError
+	// InternalError means the host encountered an unexpected error unrelated to user input.
+	InternalError
+)
+
+// Sentinel errors for the documented code catalog, so callers can write
+// errors.Is(err, tflint.ErrUnknownValue) instead of comparing (*Error).Code directly.
+var (
+	ErrUnknownValue     = &Error{Code: UnknownValue}
+	ErrNullValue        = &Error{Code: NullValue}
+	ErrUnevaluableExpr  = &Error{Code: UnevaluableExpr}
+	ErrResourceNotFound = &Error{Code: ResourceNotFound}
+	ErrTypeConversion   = &Error{Code: TypeConversionError}
+	ErrTypeMismatch     = &Error{Code: TypeMismatchError}
+	ErrInternal         = &Error{Code: InternalError}
+)
+
+// Error is the error type returned by Client methods. It round-trips across
+// the gRPC boundary via ErrorResponse without losing its Code, so callers can
+// still branch on the failure reason once the error has been reconstructed.
+type Error struct {
+	Code    Code
+	Level   Level
+	Message string
+	Cause   error
+
+	// Range is the source location the error refers to, when relevant.
+	Range hcl.Range
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("%s: %s", e.Message, e.Cause)
+	}
+	return e.Message
+}
+
+// Unwrap allows errors.Is/errors.As to see through to the cause.
+func (e *Error) Unwrap() error {
+	return e.Cause
+}
+
+// Is lets errors.Is(err, &Error{Code: ...}) match purely on Code, so callers
+// don't need to reconstruct the full Error to test for a specific failure.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+// newErrorResponse wraps err into the ErrorResponse that every handler
+// return crosses the gRPC boundary with, preserving its Code so the client
+// can reconstruct the original *Error rather than an opaque string.
+func newErrorResponse(err error) *proto.ErrorResponse {
+	if err == nil {
+		return nil
+	}
+
+	appErr, ok := err.(*Error)
+	if !ok {
+		appErr = &Error{Code: InternalError, Level: ErrorLevel, Message: err.Error()}
+	}
+
+	resp := &proto.ErrorResponse{
+		Code:    int32(appErr.Code),
+		Level:   int32(appErr.Level),
+		Message: appErr.Message,
+	}
+	if appErr.Cause != nil {
+		resp.Cause = appErr.Cause.Error()
+	}
+	if appErr.Range.Filename != "" {
+		resp.SourceRange = encodeRange(appErr.Range)
+	}
+	return resp
+}
+
+// errorFromResponse is the inverse of newErrorResponse, reconstructing a
+// *Error with its original Code so callers can branch with errors.Is.
+func errorFromResponse(resp *proto.ErrorResponse) *Error {
+	if resp == nil {
+		return nil
+	}
+
+	level := Level(resp.Level)
+	if level != WarningLevel && level != ErrorLevel {
+		// resp.Level is an untrusted wire int32; a value outside the known
+		// range (a buggy host, or a future level we don't understand yet)
+		// must not reach EnsureNoError's switch, so fall back to the safer
+		// of the two behaviors instead of carrying it through verbatim.
+		level = ErrorLevel
+	}
+
+	err := &Error{
+		Code:    Code(resp.Code),
+		Level:   level,
+		Message: resp.Message,
+		Range:   decodeRange(resp.SourceRange),
+	}
+	if resp.Cause != "" {
+		err.Cause = errors.New(resp.Cause)
+	}
+	return err
+}
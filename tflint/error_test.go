@@ -0,0 +1,125 @@
+package tflint
+
+import (
+	"errors"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+
+	"github.com/kcraley/tflint-plugin-sdk/tflint/proto"
+)
+
+func TestNewErrorResponse_PreservesCode(t *testing.T) {
+	err := &Error{
+		Code:    ResourceNotFound,
+		Level:   ErrorLevel,
+		Message: "resource not found",
+		Cause:   errors.New("no such resource"),
+		Range: hcl.Range{
+			Filename: "main.tf",
+			Start:    hcl.Pos{Line: 1, Column: 1, Byte: 0},
+			End:      hcl.Pos{Line: 1, Column: 10, Byte: 9},
+		},
+	}
+
+	resp := newErrorResponse(err)
+	if resp == nil {
+		t.Fatal("newErrorResponse returned nil for a non-nil error")
+	}
+	if Code(resp.Code) != ResourceNotFound {
+		t.Errorf("Code = %d, want %d", resp.Code, ResourceNotFound)
+	}
+	if Level(resp.Level) != ErrorLevel {
+		t.Errorf("Level = %d, want %d", resp.Level, ErrorLevel)
+	}
+	if resp.Message != err.Message {
+		t.Errorf("Message = %q, want %q", resp.Message, err.Message)
+	}
+	if resp.Cause != "no such resource" {
+		t.Errorf("Cause = %q, want %q", resp.Cause, "no such resource")
+	}
+	if resp.SourceRange == nil || resp.SourceRange.Filename != "main.tf" {
+		t.Errorf("SourceRange = %+v, want filename main.tf", resp.SourceRange)
+	}
+}
+
+func TestNewErrorResponse_WrapsPlainError(t *testing.T) {
+	resp := newErrorResponse(errors.New("boom"))
+	if resp == nil {
+		t.Fatal("newErrorResponse returned nil for a non-nil error")
+	}
+	if Code(resp.Code) != InternalError {
+		t.Errorf("Code = %d, want %d (InternalError)", resp.Code, InternalError)
+	}
+	if resp.Message != "boom" {
+		t.Errorf("Message = %q, want %q", resp.Message, "boom")
+	}
+}
+
+func TestNewErrorResponse_Nil(t *testing.T) {
+	if resp := newErrorResponse(nil); resp != nil {
+		t.Errorf("newErrorResponse(nil) = %+v, want nil", resp)
+	}
+}
+
+func TestErrorFromResponse_RoundTripsCode(t *testing.T) {
+	original := &Error{
+		Code:    TypeMismatchError,
+		Level:   WarningLevel,
+		Message: "type mismatch",
+		Cause:   errors.New("wanted string"),
+		Range: hcl.Range{
+			Filename: "variables.tf",
+			Start:    hcl.Pos{Line: 3, Column: 1, Byte: 20},
+			End:      hcl.Pos{Line: 3, Column: 5, Byte: 24},
+		},
+	}
+
+	got := errorFromResponse(newErrorResponse(original))
+	if got.Code != original.Code {
+		t.Errorf("Code = %d, want %d", got.Code, original.Code)
+	}
+	if got.Level != original.Level {
+		t.Errorf("Level = %d, want %d", got.Level, original.Level)
+	}
+	if got.Message != original.Message {
+		t.Errorf("Message = %q, want %q", got.Message, original.Message)
+	}
+	if got.Cause == nil || got.Cause.Error() != original.Cause.Error() {
+		t.Errorf("Cause = %v, want %v", got.Cause, original.Cause)
+	}
+	if got.Range.Filename != original.Range.Filename {
+		t.Errorf("Range.Filename = %q, want %q", got.Range.Filename, original.Range.Filename)
+	}
+	if !errors.Is(got, &Error{Code: TypeMismatchError}) {
+		t.Error("errors.Is did not match on Code after round-tripping through the wire")
+	}
+}
+
+func TestErrorFromResponse_Nil(t *testing.T) {
+	if err := errorFromResponse(nil); err != nil {
+		t.Errorf("errorFromResponse(nil) = %+v, want nil", err)
+	}
+}
+
+func TestErrorFromResponse_ClampsUnknownLevel(t *testing.T) {
+	resp := &proto.ErrorResponse{Code: int32(InternalError), Level: 99, Message: "boom"}
+
+	got := errorFromResponse(resp)
+	if got.Level != ErrorLevel {
+		t.Errorf("Level = %d, want %d (ErrorLevel) for an out-of-range wire value", got.Level, ErrorLevel)
+	}
+}
+
+func TestClient_EnsureNoError_UnknownLevelReturnsError(t *testing.T) {
+	c := &Client{}
+	appErr := &Error{Code: InternalError, Level: Level(99), Message: "boom"}
+
+	err := c.EnsureNoError(appErr, func() error {
+		t.Fatal("proc should not run when err is non-nil")
+		return nil
+	})
+	if !errors.Is(err, appErr) {
+		t.Errorf("EnsureNoError with an unrecognized Level returned %v, want the original error", err)
+	}
+}
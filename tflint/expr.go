@@ -0,0 +1,46 @@
+package tflint
+
+import (
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// remoteExpression is the hcl.Expression a plugin gets back from
+// WalkResourceAttributes. It carries the raw source bytes and range it was
+// built from, so it can be handed straight back to Client.EvaluateExpr
+// without the host needing to keep any plugin-side state.
+type remoteExpression struct {
+	bytes []byte
+	rng   hcl.Range
+}
+
+var _ hcl.Expression = (*remoteExpression)(nil)
+
+// Value is not evaluated locally; expressions returned by the host are
+// always evaluated by calling Client.EvaluateExpr.
+func (e *remoteExpression) Value(ctx *hcl.EvalContext) (cty.Value, hcl.Diagnostics) {
+	return cty.NilVal, hcl.Diagnostics{
+		{
+			Severity: hcl.DiagError,
+			Summary:  "Expression cannot be evaluated locally",
+			Detail:   "This expression was received from the host process and must be evaluated with Client.EvaluateExpr.",
+			Subject:  &e.rng,
+		},
+	}
+}
+
+// Variables returns no traversals; the host is responsible for resolving
+// variable references when it evaluates the expression.
+func (e *remoteExpression) Variables() []hcl.Traversal {
+	return nil
+}
+
+// Range returns the range of the expression in its original source file.
+func (e *remoteExpression) Range() hcl.Range {
+	return e.rng
+}
+
+// StartRange returns the same range as Range; the underlying expression kind isn't preserved across the wire.
+func (e *remoteExpression) StartRange() hcl.Range {
+	return e.rng
+}
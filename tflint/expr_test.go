@@ -0,0 +1,34 @@
+package tflint
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+)
+
+func TestRemoteExpression_ValueIsNotEvaluableLocally(t *testing.T) {
+	e := &remoteExpression{
+		bytes: []byte(`"foo"`),
+		rng:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 1}},
+	}
+
+	_, diags := e.Value(nil)
+	if !diags.HasErrors() {
+		t.Error("Value() returned no diagnostics, want an error directing the caller to Client.EvaluateExpr")
+	}
+}
+
+func TestRemoteExpression_RangeAndStartRangeMatch(t *testing.T) {
+	rng := hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 2, Column: 3}, End: hcl.Pos{Line: 2, Column: 8}}
+	e := &remoteExpression{rng: rng}
+
+	if e.Range() != rng {
+		t.Errorf("Range() = %+v, want %+v", e.Range(), rng)
+	}
+	if e.StartRange() != rng {
+		t.Errorf("StartRange() = %+v, want %+v", e.StartRange(), rng)
+	}
+	if e.Variables() != nil {
+		t.Errorf("Variables() = %v, want nil", e.Variables())
+	}
+}
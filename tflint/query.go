@@ -0,0 +1,137 @@
+package tflint
+
+import (
+	"context"
+
+	hcl "github.com/hashicorp/hcl/v2"
+
+	"github.com/kcraley/tflint-plugin-sdk/tflint/proto"
+)
+
+// Resource is a decoded `resource` block together with its count/for_each
+// metadata, which plugins have no way to inspect through the attribute-only
+// WalkResourceAttributes API.
+type Resource struct {
+	Type    string
+	Name    string
+	Range   hcl.Range
+	Count   hcl.Expression
+	ForEach hcl.Expression
+}
+
+// ModuleCall is a decoded `module` block.
+type ModuleCall struct {
+	Name     string
+	Source   string
+	DefRange hcl.Range
+}
+
+// Backend is the decoded `backend` block of the root module, if any.
+type Backend struct {
+	Type   string
+	Config []byte
+}
+
+// WalkResourceBlocks queries the host process for nested blocks of the given type inside
+// matching resources, such as `dynamic` blocks or provisioners, and passes each to the walker function.
+func (c *Client) WalkResourceBlocks(ctx context.Context, resource, blockType string, walker func(*hcl.Block) error) error {
+	resp, err := c.client.Blocks(ctx, &proto.BlocksRequest{Resource: resource, BlockType: blockType})
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return errorFromResponse(resp.Error)
+	}
+
+	for _, block := range resp.Blocks {
+		if err := walker(decodeBlock(block)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WalkResources queries the host process for resources matching the given type and passes
+// each, together with its count/for_each metadata, to the walker function.
+func (c *Client) WalkResources(ctx context.Context, resource string, walker func(*Resource) error) error {
+	resp, err := c.client.Resources(ctx, &proto.ResourcesRequest{Resource: resource})
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return errorFromResponse(resp.Error)
+	}
+
+	for _, r := range resp.Resources {
+		if err := walker(decodeResource(r)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WalkModuleCalls queries the host process for every `module` block in the configuration
+// and passes each to the walker function.
+func (c *Client) WalkModuleCalls(ctx context.Context, walker func(*ModuleCall) error) error {
+	resp, err := c.client.ModuleCalls(ctx, &proto.ModuleCallsRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return errorFromResponse(resp.Error)
+	}
+
+	for _, mc := range resp.ModuleCalls {
+		if err := walker(&ModuleCall{
+			Name:     mc.Name,
+			Source:   mc.Source,
+			DefRange: decodeRange(mc.DefRange),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Backend queries the host process for the root module's backend configuration.
+func (c *Client) Backend(ctx context.Context) (*Backend, error) {
+	resp, err := c.client.Backend(ctx, &proto.BackendRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		return nil, errorFromResponse(resp.Error)
+	}
+	return &Backend{Type: resp.Type, Config: resp.Config}, nil
+}
+
+// TerraformVersion queries the host process for the Terraform version constraint
+// configured for the module under inspection.
+func (c *Client) TerraformVersion(ctx context.Context) (string, error) {
+	resp, err := c.client.TerraformVersion(ctx, &proto.TerraformVersionRequest{})
+	if err != nil {
+		return "", err
+	}
+	if resp.Error != nil {
+		return "", errorFromResponse(resp.Error)
+	}
+	return resp.Version, nil
+}
+
+// Files queries the host process for the raw content of every configuration file
+// in the module under inspection, keyed by filename.
+func (c *Client) Files(ctx context.Context) (map[string][]byte, error) {
+	resp, err := c.client.Files(ctx, &proto.FilesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		return nil, errorFromResponse(resp.Error)
+	}
+
+	files := make(map[string][]byte, len(resp.Files))
+	for _, f := range resp.Files {
+		files[f.Filename] = f.Content
+	}
+	return files, nil
+}
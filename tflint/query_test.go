@@ -0,0 +1,107 @@
+package tflint
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	"github.com/kcraley/tflint-plugin-sdk/tflint/proto"
+)
+
+// fakeQueryClient implements proto.PluginClient, recording the ctx each
+// query RPC received and returning canned responses.
+type fakeQueryClient struct {
+	proto.PluginClient
+
+	gotCtx context.Context
+
+	blocksResp      *proto.BlocksResponse
+	resourcesResp   *proto.ResourcesResponse
+	moduleCallsResp *proto.ModuleCallsResponse
+	backendResp     *proto.BackendResponse
+	tfVersionResp   *proto.TerraformVersionResponse
+	filesResp       *proto.FilesResponse
+}
+
+func (f *fakeQueryClient) Blocks(ctx context.Context, in *proto.BlocksRequest, opts ...grpc.CallOption) (*proto.BlocksResponse, error) {
+	f.gotCtx = ctx
+	return f.blocksResp, nil
+}
+
+func (f *fakeQueryClient) Resources(ctx context.Context, in *proto.ResourcesRequest, opts ...grpc.CallOption) (*proto.ResourcesResponse, error) {
+	f.gotCtx = ctx
+	return f.resourcesResp, nil
+}
+
+func (f *fakeQueryClient) ModuleCalls(ctx context.Context, in *proto.ModuleCallsRequest, opts ...grpc.CallOption) (*proto.ModuleCallsResponse, error) {
+	f.gotCtx = ctx
+	return f.moduleCallsResp, nil
+}
+
+func (f *fakeQueryClient) Backend(ctx context.Context, in *proto.BackendRequest, opts ...grpc.CallOption) (*proto.BackendResponse, error) {
+	f.gotCtx = ctx
+	return f.backendResp, nil
+}
+
+func (f *fakeQueryClient) TerraformVersion(ctx context.Context, in *proto.TerraformVersionRequest, opts ...grpc.CallOption) (*proto.TerraformVersionResponse, error) {
+	f.gotCtx = ctx
+	return f.tfVersionResp, nil
+}
+
+func (f *fakeQueryClient) Files(ctx context.Context, in *proto.FilesRequest, opts ...grpc.CallOption) (*proto.FilesResponse, error) {
+	f.gotCtx = ctx
+	return f.filesResp, nil
+}
+
+func TestClient_WalkModuleCalls(t *testing.T) {
+	fake := &fakeQueryClient{
+		moduleCallsResp: &proto.ModuleCallsResponse{
+			ModuleCalls: []*proto.ModuleCall{{Name: "vpc", Source: "./modules/vpc"}},
+		},
+	}
+	c := &Client{client: fake}
+
+	var got []*ModuleCall
+	if err := c.WalkModuleCalls(context.Background(), func(mc *ModuleCall) error {
+		got = append(got, mc)
+		return nil
+	}); err != nil {
+		t.Fatalf("WalkModuleCalls: %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "vpc" || got[0].Source != "./modules/vpc" {
+		t.Errorf("walker saw %+v, want a single vpc module call", got)
+	}
+}
+
+func TestClient_Backend(t *testing.T) {
+	fake := &fakeQueryClient{backendResp: &proto.BackendResponse{Type: "s3", Config: []byte(`{}`)}}
+	c := &Client{client: fake}
+
+	ctx := context.Background()
+	backend, err := c.Backend(ctx)
+	if err != nil {
+		t.Fatalf("Backend: %v", err)
+	}
+	if fake.gotCtx != ctx {
+		t.Error("Backend did not forward the caller's context")
+	}
+	if backend.Type != "s3" {
+		t.Errorf("Backend().Type = %q, want %q", backend.Type, "s3")
+	}
+}
+
+func TestClient_Files(t *testing.T) {
+	fake := &fakeQueryClient{
+		filesResp: &proto.FilesResponse{Files: []*proto.File{{Filename: "main.tf", Content: []byte("content")}}},
+	}
+	c := &Client{client: fake}
+
+	files, err := c.Files(context.Background())
+	if err != nil {
+		t.Fatalf("Files: %v", err)
+	}
+	if string(files["main.tf"]) != "content" {
+		t.Errorf("Files()[\"main.tf\"] = %q, want %q", files["main.tf"], "content")
+	}
+}
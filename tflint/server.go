@@ -0,0 +1,197 @@
+package tflint
+
+import (
+	"context"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/kcraley/tflint-plugin-sdk/tflint/proto"
+)
+
+// Server is the host-side counterpart to Client. The host process implements
+// Server and registers a GRPCServer wrapping it so that plugins can query
+// Terraform configurations over the go-plugin gRPC transport.
+type Server interface {
+	Attributes(ctx context.Context, resource, attributeName string) (hcl.Attributes, error)
+	Blocks(ctx context.Context, resource, blockType string) ([]*hcl.Block, error)
+	Resources(ctx context.Context, resource string) ([]*Resource, error)
+	ModuleCalls(ctx context.Context) ([]*ModuleCall, error)
+	Backend(ctx context.Context) (*Backend, error)
+	TerraformVersion(ctx context.Context) (string, error)
+	Files(ctx context.Context) (map[string][]byte, error)
+	EvalExpr(ctx context.Context, expr hcl.Expression) (cty.Value, error)
+	EmitIssue(ctx context.Context, req *EmitIssueRequest) error
+	EmitIssues(ctx context.Context, reqs []*EmitIssueRequest) error
+}
+
+// GRPCServer adapts a Server implementation to the generated proto.PluginServer interface.
+type GRPCServer struct {
+	// UnimplementedPluginServer is embedded for forward compatibility with
+	// proto.PluginServer, as required by the generated code.
+	proto.UnimplementedPluginServer
+
+	Impl Server
+
+	// Sources holds the raw content of every configuration file the host has
+	// loaded, keyed by filename, so attribute expressions can be sent to
+	// plugins as source bytes instead of a gob-encoded hcl.Expression.
+	Sources map[string][]byte
+}
+
+var _ proto.PluginServer = (*GRPCServer)(nil)
+
+// Attributes implements proto.PluginServer.
+// Errors from the Impl are wrapped into the response's ErrorResponse rather
+// than returned as a gRPC transport error, so the client can reconstruct the
+// original *Error and its Code instead of an opaque status string.
+func (s *GRPCServer) Attributes(ctx context.Context, req *proto.AttributesRequest) (*proto.AttributesResponse, error) {
+	attrs, err := s.Impl.Attributes(ctx, req.Resource, req.AttributeName)
+	if err != nil {
+		return &proto.AttributesResponse{Error: newErrorResponse(err)}, nil
+	}
+
+	resp := &proto.AttributesResponse{}
+	for _, attr := range attrs {
+		resp.Attributes = append(resp.Attributes, encodeAttribute(attr, s.Sources))
+	}
+	return resp, nil
+}
+
+// Blocks implements proto.PluginServer.
+func (s *GRPCServer) Blocks(ctx context.Context, req *proto.BlocksRequest) (*proto.BlocksResponse, error) {
+	blocks, err := s.Impl.Blocks(ctx, req.Resource, req.BlockType)
+	if err != nil {
+		return &proto.BlocksResponse{Error: newErrorResponse(err)}, nil
+	}
+
+	resp := &proto.BlocksResponse{}
+	for _, block := range blocks {
+		resp.Blocks = append(resp.Blocks, encodeBlock(block, s.Sources))
+	}
+	return resp, nil
+}
+
+// Resources implements proto.PluginServer.
+func (s *GRPCServer) Resources(ctx context.Context, req *proto.ResourcesRequest) (*proto.ResourcesResponse, error) {
+	resources, err := s.Impl.Resources(ctx, req.Resource)
+	if err != nil {
+		return &proto.ResourcesResponse{Error: newErrorResponse(err)}, nil
+	}
+
+	resp := &proto.ResourcesResponse{}
+	for _, resource := range resources {
+		resp.Resources = append(resp.Resources, encodeResource(resource, s.Sources))
+	}
+	return resp, nil
+}
+
+// ModuleCalls implements proto.PluginServer.
+func (s *GRPCServer) ModuleCalls(ctx context.Context, req *proto.ModuleCallsRequest) (*proto.ModuleCallsResponse, error) {
+	moduleCalls, err := s.Impl.ModuleCalls(ctx)
+	if err != nil {
+		return &proto.ModuleCallsResponse{Error: newErrorResponse(err)}, nil
+	}
+
+	resp := &proto.ModuleCallsResponse{}
+	for _, mc := range moduleCalls {
+		resp.ModuleCalls = append(resp.ModuleCalls, &proto.ModuleCall{
+			Name:     mc.Name,
+			Source:   mc.Source,
+			DefRange: encodeRange(mc.DefRange),
+		})
+	}
+	return resp, nil
+}
+
+// Backend implements proto.PluginServer.
+func (s *GRPCServer) Backend(ctx context.Context, req *proto.BackendRequest) (*proto.BackendResponse, error) {
+	backend, err := s.Impl.Backend(ctx)
+	if err != nil {
+		return &proto.BackendResponse{Error: newErrorResponse(err)}, nil
+	}
+	if backend == nil {
+		return &proto.BackendResponse{}, nil
+	}
+	return &proto.BackendResponse{Type: backend.Type, Config: backend.Config}, nil
+}
+
+// TerraformVersion implements proto.PluginServer.
+func (s *GRPCServer) TerraformVersion(ctx context.Context, req *proto.TerraformVersionRequest) (*proto.TerraformVersionResponse, error) {
+	version, err := s.Impl.TerraformVersion(ctx)
+	if err != nil {
+		return &proto.TerraformVersionResponse{Error: newErrorResponse(err)}, nil
+	}
+	return &proto.TerraformVersionResponse{Version: version}, nil
+}
+
+// Files implements proto.PluginServer.
+func (s *GRPCServer) Files(ctx context.Context, req *proto.FilesRequest) (*proto.FilesResponse, error) {
+	files, err := s.Impl.Files(ctx)
+	if err != nil {
+		return &proto.FilesResponse{Error: newErrorResponse(err)}, nil
+	}
+
+	resp := &proto.FilesResponse{}
+	for filename, content := range files {
+		resp.Files = append(resp.Files, &proto.File{Filename: filename, Content: content})
+	}
+	return resp, nil
+}
+
+// EvalExpr implements proto.PluginServer.
+func (s *GRPCServer) EvalExpr(ctx context.Context, req *proto.EvalExprRequest) (*proto.EvalExprResponse, error) {
+	// An expression that did not come from the host arrives without source
+	// bytes (see encodeExpr); slice them out of Sources by its range.
+	e := req.Expr
+	if e != nil && len(e.Bytes) == 0 {
+		rng := decodeRange(e.Range)
+		e = &proto.Expression{Bytes: rng.SliceBytes(s.Sources[rng.Filename]), Range: e.Range}
+	}
+
+	expr, err := decodeExprRequest(e)
+	if err != nil {
+		return &proto.EvalExprResponse{Error: newErrorResponse(err)}, nil
+	}
+
+	val, err := s.Impl.EvalExpr(ctx, expr)
+	if err != nil {
+		return &proto.EvalExprResponse{Error: newErrorResponse(err)}, nil
+	}
+
+	value, err := encodeCtyValue(val)
+	if err != nil {
+		return &proto.EvalExprResponse{Error: newErrorResponse(err)}, nil
+	}
+	return &proto.EvalExprResponse{Value: value}, nil
+}
+
+// EmitIssue implements proto.PluginServer.
+func (s *GRPCServer) EmitIssue(ctx context.Context, req *proto.EmitIssueRequest) (*proto.EmitIssueResponse, error) {
+	issue, err := decodeEmitIssueRequest(req)
+	if err != nil {
+		return &proto.EmitIssueResponse{Error: newErrorResponse(err)}, nil
+	}
+
+	if err := s.Impl.EmitIssue(ctx, issue); err != nil {
+		return &proto.EmitIssueResponse{Error: newErrorResponse(err)}, nil
+	}
+	return &proto.EmitIssueResponse{}, nil
+}
+
+// EmitIssues implements proto.PluginServer.
+func (s *GRPCServer) EmitIssues(ctx context.Context, req *proto.EmitIssuesRequest) (*proto.EmitIssuesResponse, error) {
+	issues := make([]*EmitIssueRequest, len(req.Issues))
+	for i, r := range req.Issues {
+		issue, err := decodeEmitIssueRequest(r)
+		if err != nil {
+			return &proto.EmitIssuesResponse{Error: newErrorResponse(err)}, nil
+		}
+		issues[i] = issue
+	}
+
+	if err := s.Impl.EmitIssues(ctx, issues); err != nil {
+		return &proto.EmitIssuesResponse{Error: newErrorResponse(err)}, nil
+	}
+	return &proto.EmitIssuesResponse{}, nil
+}
@@ -0,0 +1,130 @@
+package tflint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/kcraley/tflint-plugin-sdk/tflint/proto"
+)
+
+// fakeServer implements Server, recording the ctx each method was called
+// with so tests can verify GRPCServer forwards the caller's context instead
+// of substituting its own.
+type fakeServer struct {
+	gotCtx context.Context
+}
+
+func (f *fakeServer) Attributes(ctx context.Context, resource, attributeName string) (hcl.Attributes, error) {
+	f.gotCtx = ctx
+	return nil, nil
+}
+
+func (f *fakeServer) Blocks(ctx context.Context, resource, blockType string) ([]*hcl.Block, error) {
+	f.gotCtx = ctx
+	return nil, nil
+}
+
+func (f *fakeServer) Resources(ctx context.Context, resource string) ([]*Resource, error) {
+	f.gotCtx = ctx
+	return nil, nil
+}
+
+func (f *fakeServer) ModuleCalls(ctx context.Context) ([]*ModuleCall, error) {
+	f.gotCtx = ctx
+	return nil, nil
+}
+
+func (f *fakeServer) Backend(ctx context.Context) (*Backend, error) {
+	f.gotCtx = ctx
+	return nil, nil
+}
+
+func (f *fakeServer) TerraformVersion(ctx context.Context) (string, error) {
+	f.gotCtx = ctx
+	return "", nil
+}
+
+func (f *fakeServer) Files(ctx context.Context) (map[string][]byte, error) {
+	f.gotCtx = ctx
+	return nil, nil
+}
+
+func (f *fakeServer) EvalExpr(ctx context.Context, expr hcl.Expression) (cty.Value, error) {
+	f.gotCtx = ctx
+	return cty.StringVal("unused"), nil
+}
+
+func (f *fakeServer) EmitIssue(ctx context.Context, req *EmitIssueRequest) error {
+	f.gotCtx = ctx
+	return nil
+}
+
+func (f *fakeServer) EmitIssues(ctx context.Context, reqs []*EmitIssueRequest) error {
+	f.gotCtx = ctx
+	return nil
+}
+
+func TestGRPCServer_ForwardsContext(t *testing.T) {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "caller")
+
+	fake := &fakeServer{}
+	s := &GRPCServer{Impl: fake}
+
+	if _, err := s.Attributes(ctx, &proto.AttributesRequest{}); err != nil {
+		t.Fatalf("Attributes: %v", err)
+	}
+	if fake.gotCtx != ctx {
+		t.Error("Attributes did not forward the caller's context to Impl.Attributes")
+	}
+
+	if _, err := s.ModuleCalls(ctx, &proto.ModuleCallsRequest{}); err != nil {
+		t.Fatalf("ModuleCalls: %v", err)
+	}
+	if fake.gotCtx != ctx {
+		t.Error("ModuleCalls did not forward the caller's context to Impl.ModuleCalls")
+	}
+}
+
+func TestGRPCServer_WrapsImplErrorIntoResponse(t *testing.T) {
+	implErr := errors.New("boom")
+	s := &GRPCServer{Impl: &erroringServer{err: implErr}}
+
+	resp, err := s.Backend(context.Background(), &proto.BackendRequest{})
+	if err != nil {
+		t.Fatalf("Backend returned a transport error %v, want the error carried in the response", err)
+	}
+	if resp.Error == nil || resp.Error.Message != implErr.Error() {
+		t.Errorf("Backend response Error = %+v, want it to wrap %v", resp.Error, implErr)
+	}
+}
+
+// erroringServer implements Server by returning err from every method.
+type erroringServer struct {
+	err error
+}
+
+func (e *erroringServer) Attributes(ctx context.Context, resource, attributeName string) (hcl.Attributes, error) {
+	return nil, e.err
+}
+func (e *erroringServer) Blocks(ctx context.Context, resource, blockType string) ([]*hcl.Block, error) {
+	return nil, e.err
+}
+func (e *erroringServer) Resources(ctx context.Context, resource string) ([]*Resource, error) {
+	return nil, e.err
+}
+func (e *erroringServer) ModuleCalls(ctx context.Context) ([]*ModuleCall, error) { return nil, e.err }
+func (e *erroringServer) Backend(ctx context.Context) (*Backend, error)          { return nil, e.err }
+func (e *erroringServer) TerraformVersion(ctx context.Context) (string, error)   { return "", e.err }
+func (e *erroringServer) Files(ctx context.Context) (map[string][]byte, error)   { return nil, e.err }
+func (e *erroringServer) EvalExpr(ctx context.Context, expr hcl.Expression) (cty.Value, error) {
+	return cty.NilVal, e.err
+}
+func (e *erroringServer) EmitIssue(ctx context.Context, req *EmitIssueRequest) error { return e.err }
+func (e *erroringServer) EmitIssues(ctx context.Context, reqs []*EmitIssueRequest) error {
+	return e.err
+}
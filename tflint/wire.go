@@ -0,0 +1,309 @@
+package tflint
+
+import (
+	"bytes"
+	"encoding/gob"
+	"strings"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	hcljson "github.com/hashicorp/hcl/v2/json"
+	"github.com/zclconf/go-cty/cty"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+	"github.com/zclconf/go-cty/cty/msgpack"
+
+	"github.com/kcraley/tflint-plugin-sdk/tflint/proto"
+)
+
+// encodeExpr converts an hcl.Expression to its wire representation. When
+// expr is a *remoteExpression (one obtained from WalkResourceAttributes),
+// its original source bytes are sent back as-is; otherwise only the range
+// crosses the wire and the host reparses the expression from its own
+// sources map.
+func encodeExpr(expr hcl.Expression) *proto.Expression {
+	if remote, ok := expr.(*remoteExpression); ok {
+		return &proto.Expression{Bytes: remote.bytes, Range: encodeRange(remote.rng)}
+	}
+	return &proto.Expression{Range: encodeRange(expr.Range())}
+}
+
+func encodeRange(r hcl.Range) *proto.Range {
+	return &proto.Range{
+		Filename: r.Filename,
+		Start:    encodePos(r.Start),
+		End:      encodePos(r.End),
+	}
+}
+
+func decodeRange(r *proto.Range) hcl.Range {
+	if r == nil {
+		return hcl.Range{}
+	}
+	return hcl.Range{
+		Filename: r.Filename,
+		Start:    decodePos(r.Start),
+		End:      decodePos(r.End),
+	}
+}
+
+func encodePos(p hcl.Pos) *proto.Pos {
+	return &proto.Pos{Line: int64(p.Line), Column: int64(p.Column), Byte: int64(p.Byte)}
+}
+
+func decodePos(p *proto.Pos) hcl.Pos {
+	if p == nil {
+		return hcl.Pos{}
+	}
+	return hcl.Pos{Line: int(p.Line), Column: int(p.Column), Byte: int(p.Byte)}
+}
+
+// decodeAttribute reconstructs an hcl.Attribute from its wire representation.
+// Its Expr is a *remoteExpression wrapping the raw source bytes the host
+// sent, so the plugin can hand it straight back to Client.EvaluateExpr
+// without the host needing to serialize an hcl.Expression implementation.
+func decodeAttribute(attr *proto.Attribute) (*hcl.Attribute, error) {
+	return &hcl.Attribute{
+		Name: attr.Name,
+		Expr: &remoteExpression{
+			bytes: attr.Expr,
+			rng:   decodeRange(attr.ExprRange),
+		},
+		Range:     decodeRange(attr.Range),
+		NameRange: decodeRange(attr.NameRange),
+	}, nil
+}
+
+// encodeAttribute is the host-side inverse of decodeAttribute. sources holds
+// the raw content of every configuration file the host has loaded, keyed by
+// filename, so the expression's source bytes can be sliced out of it.
+func encodeAttribute(attr *hcl.Attribute, sources map[string][]byte) *proto.Attribute {
+	exprRange := attr.Expr.Range()
+	return &proto.Attribute{
+		Name:      attr.Name,
+		Expr:      exprRange.SliceBytes(sources[exprRange.Filename]),
+		ExprRange: encodeRange(exprRange),
+		Range:     encodeRange(attr.Range),
+		NameRange: encodeRange(attr.NameRange),
+	}
+}
+
+// decodeBlock reconstructs an hcl.Block from its wire representation. Its
+// Body is a *remoteBody wrapping the raw source bytes the host sent, lazily
+// reparsed the first time the plugin asks for its content.
+func decodeBlock(block *proto.Block) *hcl.Block {
+	labelRanges := make([]hcl.Range, len(block.LabelRanges))
+	for i, r := range block.LabelRanges {
+		labelRanges[i] = decodeRange(r)
+	}
+
+	bodyRange := decodeRange(block.BodyRange)
+	return &hcl.Block{
+		Type:   block.Type,
+		Labels: block.Labels,
+		Body: &remoteBody{
+			bytes: block.Body,
+			rng:   bodyRange,
+		},
+		DefRange:    decodeRange(block.DefRange),
+		TypeRange:   decodeRange(block.TypeRange),
+		LabelRanges: labelRanges,
+	}
+}
+
+// bodyContentRange returns the source range of a block's contents, excluding
+// its opening and closing braces, so the bytes it slices out can be reparsed
+// by hclsyntax.ParseConfig as a standalone body. hcl.Body has no generic way
+// to ask for this: only *hclsyntax.Body exposes the brace-to-brace span, via
+// SrcRange. MissingItemRange is not a substitute, despite the name - it is a
+// zero-width range at the body's start, meant for pointing diagnostics at
+// where a missing required item should go, not for recovering its content.
+// Any other body type (such as a JSON body) still gets that zero-width
+// MissingItemRange, so the bytes sliced for it are empty and its content does
+// not cross the wire.
+func bodyContentRange(body hcl.Body) hcl.Range {
+	b, ok := body.(*hclsyntax.Body)
+	if !ok {
+		return body.MissingItemRange()
+	}
+
+	rng := b.SrcRange
+	return hcl.Range{
+		Filename: rng.Filename,
+		Start:    hcl.Pos{Line: rng.Start.Line, Column: rng.Start.Column + 1, Byte: rng.Start.Byte + 1},
+		End:      hcl.Pos{Line: rng.End.Line, Column: rng.End.Column - 1, Byte: rng.End.Byte - 1},
+	}
+}
+
+// encodeBlock is the host-side inverse of decodeBlock.
+func encodeBlock(block *hcl.Block, sources map[string][]byte) *proto.Block {
+	bodyRange := bodyContentRange(block.Body)
+	labelRanges := make([]*proto.Range, len(block.LabelRanges))
+	for i, r := range block.LabelRanges {
+		labelRanges[i] = encodeRange(r)
+	}
+
+	return &proto.Block{
+		Type:        block.Type,
+		Labels:      block.Labels,
+		Body:        bodyRange.SliceBytes(sources[bodyRange.Filename]),
+		BodyRange:   encodeRange(bodyRange),
+		DefRange:    encodeRange(block.DefRange),
+		TypeRange:   encodeRange(block.TypeRange),
+		LabelRanges: labelRanges,
+	}
+}
+
+// decodeResource reconstructs a Resource from its wire representation.
+func decodeResource(r *proto.Resource) *Resource {
+	resource := &Resource{
+		Type:  r.Type,
+		Name:  r.Name,
+		Range: decodeRange(r.Range),
+	}
+	if r.Count != nil {
+		resource.Count = &remoteExpression{bytes: r.Count.Bytes, rng: decodeRange(r.Count.Range)}
+	}
+	if r.ForEach != nil {
+		resource.ForEach = &remoteExpression{bytes: r.ForEach.Bytes, rng: decodeRange(r.ForEach.Range)}
+	}
+	return resource
+}
+
+// encodeResource is the host-side inverse of decodeResource. sources is used
+// to slice the count/for_each expressions' source bytes, the same way
+// encodeAttribute does for attribute expressions.
+func encodeResource(r *Resource, sources map[string][]byte) *proto.Resource {
+	resource := &proto.Resource{
+		Type:  r.Type,
+		Name:  r.Name,
+		Range: encodeRange(r.Range),
+	}
+	if r.Count != nil {
+		resource.Count = encodeExprWithSource(r.Count, sources)
+	}
+	if r.ForEach != nil {
+		resource.ForEach = encodeExprWithSource(r.ForEach, sources)
+	}
+	return resource
+}
+
+// encodeExprWithSource encodes a host-side hcl.Expression by slicing its
+// source bytes out of sources, the counterpart to how encodeExpr handles a
+// plugin-side *remoteExpression that already carries its own bytes.
+func encodeExprWithSource(expr hcl.Expression, sources map[string][]byte) *proto.Expression {
+	rng := expr.Range()
+	return &proto.Expression{
+		Bytes: rng.SliceBytes(sources[rng.Filename]),
+		Range: encodeRange(rng),
+	}
+}
+
+// decodeExprRequest reparses the expression bytes carried by an
+// EvalExprRequest into an hcl.Expression. The filename's extension picks
+// between the native and JSON HCL syntaxes, the same way the host parses
+// configuration files on disk. For native syntax the original range seeds the
+// parser's start position; the JSON parser does not take a start position, so
+// positions in a JSON expression are relative to the start of its bytes.
+func decodeExprRequest(e *proto.Expression) (hcl.Expression, error) {
+	rng := decodeRange(e.Range)
+
+	if strings.HasSuffix(rng.Filename, ".json") {
+		expr, diags := hcljson.ParseExpression(e.Bytes, rng.Filename)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+		return expr, nil
+	}
+
+	expr, diags := hclsyntax.ParseExpression(e.Bytes, rng.Filename, rng.Start)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return expr, nil
+}
+
+// encodeCtyValue marshals a cty.Value as msgpack bytes alongside its cty.Type
+// spec, so the value can be decoded without losing unknown/null information.
+func encodeCtyValue(val cty.Value) (*proto.CtyValue, error) {
+	ty, err := ctyjson.MarshalType(val.Type())
+	if err != nil {
+		return nil, err
+	}
+	data, err := msgpack.Marshal(val, val.Type())
+	if err != nil {
+		return nil, err
+	}
+	return &proto.CtyValue{Msgpack: data, Type: ty}, nil
+}
+
+// decodeCtyValue is the inverse of encodeCtyValue.
+func decodeCtyValue(v *proto.CtyValue) (cty.Value, error) {
+	ty, err := ctyjson.UnmarshalType(v.Type)
+	if err != nil {
+		return cty.NilVal, err
+	}
+	return msgpack.Unmarshal(v.Msgpack, ty)
+}
+
+// encodeEmitIssueRequest converts an EmitIssueRequest to its wire representation.
+// The rule and metadata still cross the wire as gob, since plugins cannot
+// register arbitrary custom types with the host ahead of time.
+func encodeEmitIssueRequest(req *EmitIssueRequest) (*proto.EmitIssueRequest, error) {
+	rule, err := gobEncode(req.Rule)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := gobEncode(req.Meta)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.EmitIssueRequest{
+		Rule:     rule,
+		Message:  req.Message,
+		Location: encodeRange(req.Location),
+		Meta:     meta,
+	}, nil
+}
+
+// decodeEmitIssueRequest is the inverse of encodeEmitIssueRequest.
+func decodeEmitIssueRequest(req *proto.EmitIssueRequest) (*EmitIssueRequest, error) {
+	var rule RuleObject
+	if err := gobDecode(req.Rule, &rule); err != nil {
+		return nil, err
+	}
+	var meta Metadata
+	if err := gobDecode(req.Meta, &meta); err != nil {
+		return nil, err
+	}
+	return &EmitIssueRequest{
+		Rule:     &rule,
+		Message:  req.Message,
+		Location: decodeRange(req.Location),
+		Meta:     meta,
+	}, nil
+}
+
+// encodeEmitIssuesRequest batches several EmitIssueRequests into a single EmitIssuesRequest.
+func encodeEmitIssuesRequest(reqs []*EmitIssueRequest) (*proto.EmitIssuesRequest, error) {
+	issues := make([]*proto.EmitIssueRequest, len(reqs))
+	for i, req := range reqs {
+		issue, err := encodeEmitIssueRequest(req)
+		if err != nil {
+			return nil, err
+		}
+		issues[i] = issue
+	}
+	return &proto.EmitIssuesRequest{Issues: issues}, nil
+}
+
+func gobEncode(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func gobDecode(data []byte, v interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
@@ -0,0 +1,142 @@
+package tflint
+
+import (
+	"testing"
+
+	hcl "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestEncodeDecodeRange_RoundTrip(t *testing.T) {
+	rng := hcl.Range{
+		Filename: "main.tf",
+		Start:    hcl.Pos{Line: 1, Column: 1, Byte: 0},
+		End:      hcl.Pos{Line: 3, Column: 2, Byte: 42},
+	}
+
+	got := decodeRange(encodeRange(rng))
+	if got != rng {
+		t.Errorf("decodeRange(encodeRange(rng)) = %+v, want %+v", got, rng)
+	}
+}
+
+func TestDecodeRange_Nil(t *testing.T) {
+	if got := decodeRange(nil); got != (hcl.Range{}) {
+		t.Errorf("decodeRange(nil) = %+v, want zero value", got)
+	}
+}
+
+func TestEncodeDecodePos_RoundTrip(t *testing.T) {
+	pos := hcl.Pos{Line: 5, Column: 9, Byte: 123}
+
+	got := decodePos(encodePos(pos))
+	if got != pos {
+		t.Errorf("decodePos(encodePos(pos)) = %+v, want %+v", got, pos)
+	}
+}
+
+func TestDecodePos_Nil(t *testing.T) {
+	if got := decodePos(nil); got != (hcl.Pos{}) {
+		t.Errorf("decodePos(nil) = %+v, want zero value", got)
+	}
+}
+
+func TestEncodeDecodeCtyValue_RoundTrip(t *testing.T) {
+	tests := map[string]cty.Value{
+		"known string": cty.StringVal("hello"),
+		"known number": cty.NumberIntVal(42),
+		"null":         cty.NullVal(cty.String),
+		"unknown":      cty.UnknownVal(cty.String),
+		"unknown list": cty.UnknownVal(cty.List(cty.String)),
+	}
+
+	for name, val := range tests {
+		t.Run(name, func(t *testing.T) {
+			wire, err := encodeCtyValue(val)
+			if err != nil {
+				t.Fatalf("encodeCtyValue: %v", err)
+			}
+
+			got, err := decodeCtyValue(wire)
+			if err != nil {
+				t.Fatalf("decodeCtyValue: %v", err)
+			}
+
+			if got.IsNull() != val.IsNull() {
+				t.Errorf("IsNull() = %v, want %v", got.IsNull(), val.IsNull())
+			}
+			if got.IsKnown() != val.IsKnown() {
+				t.Errorf("IsKnown() = %v, want %v", got.IsKnown(), val.IsKnown())
+			}
+			if !got.Type().Equals(val.Type()) {
+				t.Errorf("Type() = %#v, want %#v", got.Type(), val.Type())
+			}
+			if val.IsKnown() && !val.IsNull() && !got.RawEquals(val) {
+				t.Errorf("decodeCtyValue(encodeCtyValue(val)) = %#v, want %#v", got, val)
+			}
+		})
+	}
+}
+
+func TestEncodeDecodeBlock_RoundTrip(t *testing.T) {
+	filename := "main.tf"
+	src := []byte(`resource "aws_instance" "foo" {
+  ami = "abc123"
+
+  dynamic "tag" {
+    for_each = var.tags
+    content {
+      key = tag.key
+    }
+  }
+}
+`)
+
+	file, diags := hclsyntax.ParseConfig(src, filename, hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		t.Fatalf("ParseConfig: %s", diags)
+	}
+
+	content, diags := file.Body.Content(&hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "resource", LabelNames: []string{"type", "name"}}},
+	})
+	if diags.HasErrors() {
+		t.Fatalf("Content: %s", diags)
+	}
+	if len(content.Blocks) != 1 {
+		t.Fatalf("got %d resource blocks, want 1", len(content.Blocks))
+	}
+	block := content.Blocks[0]
+
+	sources := map[string][]byte{filename: src}
+	wire := encodeBlock(block, sources)
+	if len(wire.Body) == 0 {
+		t.Fatal("encodeBlock produced an empty Body, the block's content was lost")
+	}
+
+	decoded := decodeBlock(wire)
+	decodedContent, diags := decoded.Body.Content(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "ami", Required: true}},
+		Blocks:     []hcl.BlockHeaderSchema{{Type: "dynamic", LabelNames: []string{"name"}}},
+	})
+	if diags.HasErrors() {
+		t.Fatalf("decoded Body.Content: %s", diags)
+	}
+
+	ami, ok := decodedContent.Attributes["ami"]
+	if !ok {
+		t.Fatal("decoded body is missing the ami attribute")
+	}
+	val, diags := ami.Expr.Value(nil)
+	if diags.HasErrors() {
+		t.Fatalf("ami.Expr.Value: %s", diags)
+	}
+	if got := val.AsString(); got != "abc123" {
+		t.Errorf("ami = %q, want %q", got, "abc123")
+	}
+
+	if len(decodedContent.Blocks) != 1 || decodedContent.Blocks[0].Type != "dynamic" {
+		t.Errorf("decoded body blocks = %+v, want a single dynamic block", decodedContent.Blocks)
+	}
+}